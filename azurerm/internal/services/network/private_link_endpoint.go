@@ -0,0 +1,62 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// PrivateLinkEndpointIPAddresses walks the network interfaces attached to a Private Endpoint and
+// returns the private IP addresses discovered on each NIC, keyed by group ID (subresource name)
+// and in NIC/IP-configuration discovery order. The API doesn't give us anything more specific than
+// group ID to tell two connections of the same subresource type apart (e.g. two `blob` connections
+// to different storage accounts), so callers must consume entries for a given group ID in the same
+// order the connections were requested to avoid handing one connection another's address. This is
+// shared between the `azurerm_private_link_endpoint` resource and data source so the two stay in sync.
+func PrivateLinkEndpointIPAddresses(ctx context.Context, client network.InterfacesClient, resourceGroup string, networkInterfaces *[]network.Interface) (map[string][]string, error) {
+	privateIPAddresses := make(map[string][]string)
+
+	if networkInterfaces == nil {
+		return privateIPAddresses, nil
+	}
+
+	for _, nicId := range *networkInterfaces {
+		if nicId.ID == nil {
+			continue
+		}
+
+		parsedNicId, err := azure.ParseAzureResourceID(*nicId.ID)
+		if err != nil {
+			return nil, err
+		}
+		nicName := parsedNicId.Path["networkInterfaces"]
+
+		nicResp, err := client.Get(ctx, resourceGroup, nicName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(nicResp.Response) {
+				return nil, fmt.Errorf("Network Interface %q (Resource Group %q) was not found", nicName, resourceGroup)
+			}
+			return nil, fmt.Errorf("Error making Read request on Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
+		}
+
+		if nicProps := nicResp.InterfacePropertiesFormat; nicProps != nil {
+			if configs := nicProps.IPConfigurations; configs != nil {
+				for _, config := range *configs {
+					if ipProps := config.InterfaceIPConfigurationPropertiesFormat; ipProps != nil {
+						if linkProps := ipProps.PrivateLinkConnectionProperties; linkProps != nil && linkProps.GroupID != nil {
+							if v := ipProps.PrivateIPAddress; v != nil {
+								groupID := *linkProps.GroupID
+								privateIPAddresses[groupID] = append(privateIPAddresses[groupID], *v)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return privateIPAddresses, nil
+}
@@ -3,8 +3,9 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"sort"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
@@ -48,7 +49,6 @@ func resourceArmPrivateLinkEndpoint() *schema.Resource {
 			"private_service_connection": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -102,6 +102,111 @@ func resourceArmPrivateLinkEndpoint() *schema.Resource {
 				},
 			},
 
+			"ip_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"group_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"member_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"private_ip_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"custom_network_interface_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"private_dns_zone_group": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"private_dns_zone_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+					},
+				},
+			},
+
+			"private_dns_zone_configs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"record_sets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fqdn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"ip_addresses": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"ttl": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -133,6 +238,8 @@ func resourceArmPrivateLinkEndpointCreateUpdate(d *schema.ResourceData, meta int
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	privateServiceConnections := d.Get("private_service_connection").([]interface{})
 	subnetId := d.Get("subnet_id").(string)
+	ipConfigurations := d.Get("ip_configuration").([]interface{})
+	customNetworkInterfaceName := d.Get("custom_network_interface_name").(string)
 	t := d.Get("tags").(map[string]interface{})
 
 	parameters := network.PrivateEndpoint{
@@ -143,10 +250,15 @@ func resourceArmPrivateLinkEndpointCreateUpdate(d *schema.ResourceData, meta int
 			Subnet: &network.Subnet{
 				ID: utils.String(subnetId),
 			},
+			IPConfigurations: expandArmPrivateLinkEndpointIPConfiguration(ipConfigurations),
 		},
 		Tags: tags.Expand(t),
 	}
 
+	if customNetworkInterfaceName != "" {
+		parameters.PrivateEndpointProperties.CustomNetworkInterfaceName = utils.String(customNetworkInterfaceName)
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
 	if err != nil {
 		return fmt.Errorf("Error creating Private Link Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -164,9 +276,89 @@ func resourceArmPrivateLinkEndpointCreateUpdate(d *schema.ResourceData, meta int
 	}
 	d.SetId(*resp.ID)
 
+	if err := resourceArmPrivateLinkEndpointDNSZoneGroupCreateUpdateDelete(d, meta, resourceGroup, name); err != nil {
+		return err
+	}
+
 	return resourceArmPrivateLinkEndpointRead(d, meta)
 }
 
+func resourceArmPrivateLinkEndpointDNSZoneGroupCreateUpdateDelete(d *schema.ResourceData, meta interface{}, resourceGroup, privateEndpointName string) error {
+	client := meta.(*ArmClient).Network.PrivateDNSZoneGroupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	dnsZoneGroups := d.Get("private_dns_zone_group").([]interface{})
+
+	if len(dnsZoneGroups) == 0 {
+		if d.IsNewResource() {
+			return nil
+		}
+
+		old, _ := d.GetChange("private_dns_zone_group")
+		oldGroups := old.([]interface{})
+		if len(oldGroups) == 0 {
+			return nil
+		}
+		oldName := oldGroups[0].(map[string]interface{})["name"].(string)
+
+		future, err := client.Delete(ctx, resourceGroup, privateEndpointName, oldName)
+		if err != nil {
+			return fmt.Errorf("Error deleting Private DNS Zone Group %q (Private Link Endpoint %q / Resource Group %q): %+v", oldName, privateEndpointName, resourceGroup, err)
+		}
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for deletion of Private DNS Zone Group %q (Private Link Endpoint %q / Resource Group %q): %+v", oldName, privateEndpointName, resourceGroup, err)
+		}
+
+		return nil
+	}
+
+	group := dnsZoneGroups[0].(map[string]interface{})
+	name := group["name"].(string)
+	zoneIds := group["private_dns_zone_ids"].([]interface{})
+
+	if !d.IsNewResource() {
+		old, _ := d.GetChange("private_dns_zone_group")
+		oldGroups := old.([]interface{})
+		if len(oldGroups) > 0 {
+			oldName := oldGroups[0].(map[string]interface{})["name"].(string)
+			if oldName != "" && oldName != name {
+				future, err := client.Delete(ctx, resourceGroup, privateEndpointName, oldName)
+				if err != nil {
+					return fmt.Errorf("Error deleting Private DNS Zone Group %q (Private Link Endpoint %q / Resource Group %q): %+v", oldName, privateEndpointName, resourceGroup, err)
+				}
+				if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("Error waiting for deletion of Private DNS Zone Group %q (Private Link Endpoint %q / Resource Group %q): %+v", oldName, privateEndpointName, resourceGroup, err)
+				}
+			}
+		}
+	}
+
+	configs := make([]network.PrivateDNSZoneConfig, 0)
+	for _, zoneId := range zoneIds {
+		configs = append(configs, network.PrivateDNSZoneConfig{
+			PrivateDNSZonePropertiesFormat: &network.PrivateDNSZonePropertiesFormat{
+				PrivateDNSZoneID: utils.String(zoneId.(string)),
+			},
+		})
+	}
+
+	parameters := network.PrivateDNSZoneGroup{
+		PrivateDNSZoneGroupPropertiesFormat: &network.PrivateDNSZoneGroupPropertiesFormat{
+			PrivateDNSZoneConfigs: &configs,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, privateEndpointName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private DNS Zone Group %q (Private Link Endpoint %q / Resource Group %q): %+v", name, privateEndpointName, resourceGroup, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Private DNS Zone Group %q (Private Link Endpoint %q / Resource Group %q): %+v", name, privateEndpointName, resourceGroup, err)
+	}
+
+	return nil
+}
+
 func resourceArmPrivateLinkEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).Network.PrivateEndpointClient
 	ctx := meta.(*ArmClient).StopContext
@@ -198,53 +390,45 @@ func resourceArmPrivateLinkEndpointRead(d *schema.ResourceData, meta interface{}
 			d.Set("subnet_id", subnet.ID)
 		}
 
-		privateIpAddress := ""
+		d.Set("custom_network_interface_name", props.CustomNetworkInterfaceName)
+
+		if err := d.Set("ip_configuration", flattenArmPrivateLinkEndpointIPConfiguration(props.IPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `ip_configuration`: %+v", err)
+		}
+
+		privateIpAddresses := make(map[string][]string)
 
 		if props.NetworkInterfaces != nil {
 			if err := d.Set("network_interface_ids", flattenArmPrivateLinkEndpointInterface(props.NetworkInterfaces)); err != nil {
 				return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
 			}
 
-			// now we need to get the nic to get the private ip address for the private link endpoint
-			client := meta.(*ArmClient).Network.InterfacesClient
-			ctx := meta.(*ArmClient).StopContext
-
-			nic := d.Get("network_interface_ids").([]interface{})
-
-			nicId, err := azure.ParseAzureResourceID(nic[0].(string))
+			interfacesClient := meta.(*ArmClient).Network.InterfacesClient
+			privateIpAddresses, err = aznet.PrivateLinkEndpointIPAddresses(ctx, interfacesClient, resourceGroup, props.NetworkInterfaces)
 			if err != nil {
 				return err
 			}
-			nicName := nicId.Path["networkInterfaces"]
-
-			nicResp, err := client.Get(ctx, resourceGroup, nicName, "")
-			if err != nil {
-				if utils.ResponseWasNotFound(nicResp.Response) {
-					return fmt.Errorf("Azure Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
-				}
-				return fmt.Errorf("Error making Read request on Azure Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
-			}
-
-			if nicProps := nicResp.InterfacePropertiesFormat; nicProps != nil {
-				if configs := nicProps.IPConfigurations; configs != nil {
-					for i, config := range *nicProps.IPConfigurations {
-						if ipProps := config.InterfaceIPConfigurationPropertiesFormat; ipProps != nil {
-							if v := ipProps.PrivateIPAddress; v != nil {
-								if i == 0 {
-									privateIpAddress = *v
-								}
-							}
-						}
-					}
-				}
-			}
 		}
 
-		if err := d.Set("private_service_connection", flattenArmPrivateLinkEndpointServiceConnection(props.PrivateLinkServiceConnections, props.ManualPrivateLinkServiceConnections, privateIpAddress)); err != nil {
+		existingConnections := d.Get("private_service_connection").([]interface{})
+		if err := d.Set("private_service_connection", flattenArmPrivateLinkEndpointServiceConnection(props.PrivateLinkServiceConnections, props.ManualPrivateLinkServiceConnections, privateIpAddresses, existingConnections)); err != nil {
 			return fmt.Errorf("Error setting `private_service_connection`: %+v", err)
 		}
 	}
 
+	dnsZoneGroupClient := meta.(*ArmClient).Network.PrivateDNSZoneGroupsClient
+	dnsZoneGroups, err := dnsZoneGroupClient.List(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error listing Private DNS Zone Groups for Private Link Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	dnsZoneGroupList := dnsZoneGroups.Values()
+	if err := d.Set("private_dns_zone_group", flattenArmPrivateLinkEndpointDNSZoneGroup(dnsZoneGroupList)); err != nil {
+		return fmt.Errorf("Error setting `private_dns_zone_group`: %+v", err)
+	}
+	if err := d.Set("private_dns_zone_configs", flattenArmPrivateLinkEndpointDNSZoneConfigs(dnsZoneGroupList)); err != nil {
+		return fmt.Errorf("Error setting `private_dns_zone_configs`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -276,6 +460,64 @@ func resourceArmPrivateLinkEndpointDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func expandArmPrivateLinkEndpointIPConfiguration(input []interface{}) *[]network.PrivateEndpointIPConfiguration {
+	results := make([]network.PrivateEndpointIPConfiguration, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		name := v["name"].(string)
+		groupId := v["group_id"].(string)
+		memberName := v["member_name"].(string)
+		privateIpAddress := v["private_ip_address"].(string)
+
+		results = append(results, network.PrivateEndpointIPConfiguration{
+			Name: utils.String(name),
+			PrivateEndpointIPConfigurationProperties: &network.PrivateEndpointIPConfigurationProperties{
+				GroupID:          utils.String(groupId),
+				MemberName:       utils.String(memberName),
+				PrivateIPAddress: utils.String(privateIpAddress),
+			},
+		})
+	}
+
+	if len(results) == 0 {
+		return nil
+	}
+
+	return &results
+}
+
+func flattenArmPrivateLinkEndpointIPConfiguration(input *[]network.PrivateEndpointIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		v := make(map[string]interface{})
+
+		if name := item.Name; name != nil {
+			v["name"] = *name
+		}
+
+		if props := item.PrivateEndpointIPConfigurationProperties; props != nil {
+			if groupId := props.GroupID; groupId != nil {
+				v["group_id"] = *groupId
+			}
+			if memberName := props.MemberName; memberName != nil {
+				v["member_name"] = *memberName
+			}
+			if privateIpAddress := props.PrivateIPAddress; privateIpAddress != nil {
+				v["private_ip_address"] = *privateIpAddress
+			}
+		}
+
+		results = append(results, v)
+	}
+
+	return results
+}
+
 func expandArmPrivateLinkEndpointServiceConnection(input []interface{}, parseManual bool) *[]network.PrivateLinkServiceConnection {
 	results := make([]network.PrivateLinkServiceConnection, 0)
 	for _, item := range input {
@@ -306,82 +548,100 @@ func expandArmPrivateLinkEndpointServiceConnection(input []interface{}, parseMan
 	return &results
 }
 
-func flattenArmPrivateLinkEndpointServiceConnection(serviceConnections *[]network.PrivateLinkServiceConnection, manualServiceConnections *[]network.PrivateLinkServiceConnection, privateIpAddress string) []interface{} {
+// flattenArmPrivateLinkEndpointServiceConnection flattens the (separate) automatic/manual connection
+// arrays the API returns back into a single list. `existingOrder` is the `private_service_connection`
+// list as it was already stored in state/config (i.e. `d.Get` read before this call overwrites it);
+// entries are matched back to their previous position by name and keep it, so that re-ordering the API
+// happens to return doesn't show up as a plan diff, and any genuinely new connection is appended at the
+// end instead of forcing the whole list into alphabetical order.
+func flattenArmPrivateLinkEndpointServiceConnection(serviceConnections *[]network.PrivateLinkServiceConnection, manualServiceConnections *[]network.PrivateLinkServiceConnection, privateIpAddresses map[string][]string, existingOrder []interface{}) []interface{} {
 	results := make([]interface{}, 0)
 	if serviceConnections == nil && manualServiceConnections == nil {
 		return results
 	}
 
-	if serviceConnections != nil {
-		for _, item := range *serviceConnections {
-			v := make(map[string]interface{})
+	ipAddressesConsumed := make(map[string]int)
 
-			if name := item.Name; name != nil {
-				v["name"] = *name
-			}
+	flattenConnection := func(item network.PrivateLinkServiceConnection, isManual bool) map[string]interface{} {
+		v := make(map[string]interface{})
 
-			v["is_manual_connection"] = false
-			v["private_ip_address"] = privateIpAddress
+		if name := item.Name; name != nil {
+			v["name"] = *name
+		}
 
-			if props := item.PrivateLinkServiceConnectionProperties; props != nil {
-				if subresourceNames := props.GroupIds; subresourceNames != nil {
-					v["subresource_names"] = utils.FlattenStringSlice(subresourceNames)
-				}
-				if privateConnectionResourceId := props.PrivateLinkServiceID; privateConnectionResourceId != nil {
-					v["private_connection_resource_id"] = *privateConnectionResourceId
-				}
-				if requestMessage := props.RequestMessage; requestMessage != nil {
-					v["request_message"] = *requestMessage
-				}
-				if provisioningState := props.ProvisioningState; provisioningState != "" {
-					v["provisioning_state"] = provisioningState
-				}
+		v["is_manual_connection"] = isManual
+
+		if props := item.PrivateLinkServiceConnectionProperties; props != nil {
+			if subresourceNames := props.GroupIds; subresourceNames != nil {
+				v["subresource_names"] = utils.FlattenStringSlice(subresourceNames)
 
-				if s := props.PrivateLinkServiceConnectionState; s != nil {
-					if status := s.Status; status != nil {
-						v["status"] = *status
+				if len(*subresourceNames) > 0 {
+					groupId := (*subresourceNames)[0]
+					ips := privateIpAddresses[groupId]
+					consumed := ipAddressesConsumed[groupId]
+					if consumed < len(ips) {
+						v["private_ip_address"] = ips[consumed]
+						ipAddressesConsumed[groupId] = consumed + 1
 					}
 				}
 			}
+			if privateConnectionResourceId := props.PrivateLinkServiceID; privateConnectionResourceId != nil {
+				v["private_connection_resource_id"] = *privateConnectionResourceId
+			}
+			if requestMessage := props.RequestMessage; requestMessage != nil {
+				v["request_message"] = *requestMessage
+			}
+			if provisioningState := props.ProvisioningState; provisioningState != "" {
+				v["provisioning_state"] = provisioningState
+			}
 
-			results = append(results, v)
+			if s := props.PrivateLinkServiceConnectionState; s != nil {
+				if status := s.Status; status != nil {
+					v["status"] = *status
+				}
+			}
+		}
+
+		return v
+	}
+
+	if serviceConnections != nil {
+		for _, item := range *serviceConnections {
+			results = append(results, flattenConnection(item, false))
 		}
 	}
 
 	if manualServiceConnections != nil {
 		for _, item := range *manualServiceConnections {
-			v := make(map[string]interface{})
+			results = append(results, flattenConnection(item, true))
+		}
+	}
 
-			if name := item.Name; name != nil {
-				v["name"] = *name
+	existingOrderIndex := make(map[string]int)
+	for i, item := range existingOrder {
+		if v, ok := item.(map[string]interface{}); ok {
+			if name, ok := v["name"].(string); ok {
+				existingOrderIndex[name] = i
 			}
+		}
+	}
 
-			v["is_manual_connection"] = true
-			v["private_ip_address"] = privateIpAddress
-
-			if props := item.PrivateLinkServiceConnectionProperties; props != nil {
-				if subresourceNames := props.GroupIds; subresourceNames != nil {
-					v["subresource_names"] = utils.FlattenStringSlice(subresourceNames)
-				}
-				if privateConnectionResourceId := props.PrivateLinkServiceID; privateConnectionResourceId != nil {
-					v["private_connection_resource_id"] = *privateConnectionResourceId
-				}
-				if requestMessage := props.RequestMessage; requestMessage != nil {
-					v["request_message"] = *requestMessage
-				}
-				if provisioningState := props.ProvisioningState; provisioningState != "" {
-					v["provisioning_state"] = provisioningState
-				}
-				if s := props.PrivateLinkServiceConnectionState; s != nil {
-					if status := s.Status; status != nil {
-						v["status"] = *status
-					}
-				}
-			}
+	sort.SliceStable(results, func(i, j int) bool {
+		nameI, _ := results[i].(map[string]interface{})["name"].(string)
+		nameJ, _ := results[j].(map[string]interface{})["name"].(string)
+		indexI, knownI := existingOrderIndex[nameI]
+		indexJ, knownJ := existingOrderIndex[nameJ]
 
-			results = append(results, v)
+		if knownI && knownJ {
+			return indexI < indexJ
 		}
-	}
+		if knownI != knownJ {
+			// connections already present in state/config keep their position; anything new sorts after them
+			return knownI
+		}
+		// neither side has a prior position (e.g. on import, or in the data source) - fall back to a stable, deterministic order
+		return nameI < nameJ
+	})
 
 	return results
 }
@@ -401,3 +661,79 @@ func flattenArmPrivateLinkEndpointInterface(input *[]network.Interface) []string
 
 	return results
 }
+
+func flattenArmPrivateLinkEndpointDNSZoneGroup(input []network.PrivateDNSZoneGroup) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, group := range input {
+		v := make(map[string]interface{})
+
+		if name := group.Name; name != nil {
+			v["name"] = *name
+		}
+
+		zoneIds := make([]interface{}, 0)
+		if props := group.PrivateDNSZoneGroupPropertiesFormat; props != nil {
+			if configs := props.PrivateDNSZoneConfigs; configs != nil {
+				for _, config := range *configs {
+					if configProps := config.PrivateDNSZonePropertiesFormat; configProps != nil {
+						if zoneId := configProps.PrivateDNSZoneID; zoneId != nil {
+							zoneIds = append(zoneIds, *zoneId)
+						}
+					}
+				}
+			}
+		}
+		v["private_dns_zone_ids"] = zoneIds
+
+		results = append(results, v)
+	}
+
+	return results
+}
+
+func flattenArmPrivateLinkEndpointDNSZoneConfigs(input []network.PrivateDNSZoneGroup) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, group := range input {
+		props := group.PrivateDNSZoneGroupPropertiesFormat
+		if props == nil || props.PrivateDNSZoneConfigs == nil {
+			continue
+		}
+
+		for _, config := range *props.PrivateDNSZoneConfigs {
+			v := make(map[string]interface{})
+
+			if name := config.Name; name != nil {
+				v["name"] = *name
+			}
+
+			recordSets := make([]interface{}, 0)
+			if configProps := config.PrivateDNSZonePropertiesFormat; configProps != nil && configProps.RecordSets != nil {
+				for _, recordSet := range *configProps.RecordSets {
+					r := make(map[string]interface{})
+
+					if fqdn := recordSet.Fqdn; fqdn != nil {
+						r["fqdn"] = *fqdn
+					}
+					if ttl := recordSet.TTL; ttl != nil {
+						r["ttl"] = int(*ttl)
+					}
+					if recordType := recordSet.RecordType; recordType != nil {
+						r["type"] = *recordType
+					}
+					if ipAddresses := recordSet.IPAddresses; ipAddresses != nil {
+						r["ip_addresses"] = utils.FlattenStringSlice(ipAddresses)
+					}
+
+					recordSets = append(recordSets, r)
+				}
+			}
+			v["record_sets"] = recordSets
+
+			results = append(results, v)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,192 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// resourceArmPrivateLinkEndpointConnectionApproval approves/rejects a pending Private Endpoint
+// connection from the service provider side.
+//
+// NOTE: this intentionally only supports `Microsoft.Network/privateLinkServices` and not the
+// "or PaaS resource ID" half of the original ask (storage, sql, keyvault, etc. each expose their
+// own `PrivateEndpointConnections` API, under their own client/SDK package and, in several cases,
+// their own API version/shape for `PrivateLinkServiceConnectionState`). Wiring up per-provider
+// dispatch is a materially bigger change than this resource - it's being called out here as an
+// explicit scope cut rather than left for `validatePrivateLinkServiceID` to quietly enforce; a
+// generic/provider-specific version of this resource should be tracked as its own follow-up.
+func resourceArmPrivateLinkEndpointConnectionApproval() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateLinkEndpointConnectionApprovalCreateUpdate,
+		Read:   resourceArmPrivateLinkEndpointConnectionApprovalRead,
+		Update: resourceArmPrivateLinkEndpointConnectionApprovalCreateUpdate,
+		Delete: resourceArmPrivateLinkEndpointConnectionApprovalDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// only `Microsoft.Network/privateLinkServices` IDs are accepted - see the scope note above
+			// `resourceArmPrivateLinkEndpointConnectionApproval`.
+			"private_link_service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePrivateLinkServiceID,
+			},
+
+			"connection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Approved),
+					string(network.Rejected),
+				}, false),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func validatePrivateLinkServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	id, err := azure.ParseAzureResourceID(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid resource id: %v", k, err))
+		return warnings, errors
+	}
+
+	if _, ok := id.Path["privateLinkServices"]; !ok {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a `Microsoft.Network/privateLinkServices` resource - approving connections against other Private Link providers (storage, sql, keyvault, etc.) is not supported by this resource", k))
+	}
+
+	return warnings, errors
+}
+
+func resourceArmPrivateLinkEndpointConnectionApprovalCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateLinkServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	privateLinkServiceId := d.Get("private_link_service_id").(string)
+	connectionName := d.Get("connection_name").(string)
+	status := d.Get("status").(string)
+	description := d.Get("description").(string)
+
+	id, err := azure.ParseAzureResourceID(privateLinkServiceId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["privateLinkServices"]
+
+	parameters := network.PrivateEndpointConnection{
+		PrivateEndpointConnectionProperties: &network.PrivateEndpointConnectionProperties{
+			PrivateLinkServiceConnectionState: &network.PrivateLinkServiceConnectionState{
+				Status:      utils.String(status),
+				Description: utils.String(description),
+			},
+		},
+	}
+
+	if _, err := client.UpdatePrivateEndpointConnection(ctx, resourceGroup, serviceName, connectionName, parameters); err != nil {
+		return fmt.Errorf("Error setting status of Private Endpoint Connection %q (Private Link Service %q / Resource Group %q): %+v", connectionName, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/privateEndpointConnections/%s", privateLinkServiceId, connectionName))
+
+	return resourceArmPrivateLinkEndpointConnectionApprovalRead(d, meta)
+}
+
+func resourceArmPrivateLinkEndpointConnectionApprovalRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).Network.PrivateLinkServicesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["privateLinkServices"]
+	connectionName := id.Path["privateEndpointConnections"]
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Private Link Service %q does not exist - removing Private Endpoint Connection Approval from state", serviceName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Private Link Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	privateLinkServiceId := ""
+	if resp.ID != nil {
+		privateLinkServiceId = *resp.ID
+	}
+	d.Set("private_link_service_id", privateLinkServiceId)
+	d.Set("connection_name", connectionName)
+
+	found := false
+	if props := resp.PrivateLinkServiceProperties; props != nil {
+		if connections := props.PrivateEndpointConnections; connections != nil {
+			for _, connection := range *connections {
+				if connection.Name == nil || *connection.Name != connectionName {
+					continue
+				}
+
+				found = true
+				if connProps := connection.PrivateEndpointConnectionProperties; connProps != nil {
+					if state := connProps.PrivateLinkServiceConnectionState; state != nil {
+						if status := state.Status; status != nil {
+							d.Set("status", *status)
+						}
+						if description := state.Description; description != nil {
+							d.Set("description", *description)
+						}
+					}
+				}
+				break
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[INFO] Private Endpoint Connection %q does not exist on Private Link Service %q - removing Private Endpoint Connection Approval from state", connectionName, serviceName)
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceArmPrivateLinkEndpointConnectionApprovalDelete(d *schema.ResourceData, meta interface{}) error {
+	// there's no API to "unapprove" a connection - removing this resource only stops Terraform
+	// from managing the approval status of the connection going forward.
+	log.Printf("[INFO] Removing Private Endpoint Connection Approval %q from state - this does not change the connection's status in Azure", d.Id())
+	return nil
+}